@@ -0,0 +1,47 @@
+// Package hashingtest provides deterministic entropy sources for tests of
+// password hashers.
+//
+// The readers defined here are predictable by design and must never be used
+// to generate salts outside of tests.
+package hashingtest
+
+import "io"
+
+// ZeroReader is an io.Reader that yields an endless stream of zero bytes.
+var ZeroReader io.Reader = zeroReader{}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// FixedReader returns an io.Reader that endlessly yields the bytes of b,
+// starting over from the first byte once the last one has been read.
+//
+// b is copied, so later changes to it do not affect the returned reader.
+// FixedReader panics if b is empty.
+func FixedReader(b []byte) io.Reader {
+	if len(b) == 0 {
+		panic("hashingtest: FixedReader requires at least one byte")
+	}
+
+	return &fixedReader{data: append([]byte(nil), b...)}
+}
+
+type fixedReader struct {
+	data []byte
+	off  int
+}
+
+func (r *fixedReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		c := copy(p[n:], r.data[r.off:])
+		n += c
+		r.off = (r.off + c) % len(r.data)
+	}
+
+	return n, nil
+}
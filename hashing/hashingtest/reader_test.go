@@ -0,0 +1,71 @@
+package hashingtest
+
+import (
+	"bytes"
+	"io"
+	"testing"
+)
+
+func TestFixedReader_ReadFull(t *testing.T) {
+	got := make([]byte, 7)
+	if _, err := io.ReadFull(FixedReader([]byte{1, 2, 3}), got); err != nil {
+		t.Fatalf("ReadFull: %v", err)
+	}
+
+	want := []byte{1, 2, 3, 1, 2, 3, 1}
+	if !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFixedReader_ShortReads(t *testing.T) {
+	r := FixedReader([]byte{1, 2, 3})
+
+	for _, want := range [][]byte{{1, 2}, {3, 1}} {
+		got := make([]byte, 2)
+		n, err := r.Read(got)
+		if err != nil {
+			t.Fatalf("Read: %v", err)
+		}
+
+		if n != len(got) || !bytes.Equal(got, want) {
+			t.Errorf("got %v (n=%d), want %v", got, n, want)
+		}
+	}
+}
+
+func TestFixedReader_CopiesInput(t *testing.T) {
+	b := []byte{1, 2, 3}
+	r := FixedReader(b)
+	b[0] = 9
+
+	got := make([]byte, 3)
+	if _, err := io.ReadFull(r, got); err != nil {
+		t.Fatalf("ReadFull: %v", err)
+	}
+
+	if want := []byte{1, 2, 3}; !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestFixedReader_PanicsOnEmpty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("FixedReader(nil) did not panic")
+		}
+	}()
+
+	FixedReader(nil)
+}
+
+func TestZeroReader(t *testing.T) {
+	got := []byte{1, 2, 3, 4}
+	if _, err := io.ReadFull(ZeroReader, got); err != nil {
+		t.Fatalf("ReadFull: %v", err)
+	}
+
+	if want := make([]byte, 4); !bytes.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
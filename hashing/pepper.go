@@ -0,0 +1,61 @@
+// Package hashing provides helpers shared by password hashing schemes.
+package hashing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lvjp/go-utils/hashing/phc"
+)
+
+// pepperSeparator separates the pepper key ID from the PHC string in the
+// combined storage format. Key IDs may not contain it and splitting happens
+// at its first occurrence, which keeps the format unambiguous.
+const pepperSeparator = ":"
+
+// JoinPeppered combines the ID of the pepper key used when hashing with the
+// resulting PHC string, so both can be stored in a single column.
+//
+// The combined format is keyID + ":" + phcString, for example
+// "k1:$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$aGFzaA". keyID must be non-empty
+// and must not contain ':', and phcString must start with a valid "$id"
+// segment.
+func JoinPeppered(keyID, phcString string) (string, error) {
+	if err := validatePeppered(keyID, phcString); err != nil {
+		return "", err
+	}
+
+	return keyID + pepperSeparator + phcString, nil
+}
+
+// SplitPeppered splits a string produced by JoinPeppered back into the pepper
+// key ID and the PHC string.
+func SplitPeppered(stored string) (keyID string, phcString string, err error) {
+	keyID, phcString, ok := strings.Cut(stored, pepperSeparator)
+	if !ok {
+		return "", "", errors.New("hashing: peppered string has no key ID separator")
+	}
+
+	if err := validatePeppered(keyID, phcString); err != nil {
+		return "", "", err
+	}
+
+	return keyID, phcString, nil
+}
+
+func validatePeppered(keyID, phcString string) error {
+	if keyID == "" {
+		return errors.New("hashing: empty pepper key ID")
+	}
+
+	if strings.Contains(keyID, pepperSeparator) {
+		return fmt.Errorf("hashing: pepper key ID %q contains %q", keyID, pepperSeparator)
+	}
+
+	if _, err := phc.PeekID(phcString); err != nil {
+		return fmt.Errorf("hashing: invalid peppered PHC string: %w", err)
+	}
+
+	return nil
+}
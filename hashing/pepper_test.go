@@ -0,0 +1,73 @@
+package hashing
+
+import "testing"
+
+func TestPeppered_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyID     string
+		phcString string
+	}{
+		{name: "full hash", keyID: "k1", phcString: "$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$aGFzaA"},
+		{name: "key ID with separator-like dollar", keyID: "$k", phcString: "$argon2id$v=19$m=65536,t=3,p=4$c2FsdA$aGFzaA"},
+		{name: "id only", keyID: "k", phcString: "$scrypt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stored, err := JoinPeppered(tt.keyID, tt.phcString)
+			if err != nil {
+				t.Fatalf("JoinPeppered(%q, %q): %v", tt.keyID, tt.phcString, err)
+			}
+
+			keyID, phcString, err := SplitPeppered(stored)
+			if err != nil {
+				t.Fatalf("SplitPeppered(%q): %v", stored, err)
+			}
+
+			if keyID != tt.keyID || phcString != tt.phcString {
+				t.Errorf("SplitPeppered(%q) = %q, %q, want %q, %q", stored, keyID, phcString, tt.keyID, tt.phcString)
+			}
+		})
+	}
+}
+
+func TestSplitPeppered_Malformed(t *testing.T) {
+	tests := []struct {
+		name   string
+		stored string
+	}{
+		{name: "no separator", stored: "nocolon"},
+		{name: "empty PHC string", stored: "k:"},
+		{name: "empty key ID", stored: ":$a"},
+		{name: "PHC string without leading dollar", stored: "k:argon2id$v=19"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := SplitPeppered(tt.stored); err == nil {
+				t.Errorf("SplitPeppered(%q) succeeded, want error", tt.stored)
+			}
+		})
+	}
+}
+
+func TestJoinPeppered_Malformed(t *testing.T) {
+	tests := []struct {
+		name      string
+		keyID     string
+		phcString string
+	}{
+		{name: "empty key ID", keyID: "", phcString: "$a"},
+		{name: "key ID with separator", keyID: "k:1", phcString: "$a"},
+		{name: "PHC string without leading dollar", keyID: "k", phcString: "argon2id$v=19"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := JoinPeppered(tt.keyID, tt.phcString); err == nil {
+				t.Errorf("JoinPeppered(%q, %q) succeeded, want error", tt.keyID, tt.phcString)
+			}
+		})
+	}
+}
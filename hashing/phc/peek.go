@@ -0,0 +1,49 @@
+// Package phc handles strings in the PHC string format described at
+// https://github.com/P-H-C/phc-string-format/blob/master/phc-sf-spec.md.
+package phc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// maxIDLength is the maximum length of a function identifier allowed by the
+// PHC string format specification.
+const maxIDLength = 32
+
+// PeekID returns the function identifier of the PHC string raw, for example
+// "argon2id" for "$argon2id$v=19$...".
+//
+// Only the leading "$id" segment is inspected: the rest of raw is neither
+// parsed nor validated, so a nil error does not mean that raw is a valid PHC
+// string. This is meant for routing a string to the right decoder cheaply.
+func PeekID(raw string) (string, error) {
+	rest, ok := strings.CutPrefix(raw, "$")
+	if !ok {
+		return "", errors.New("phc: missing leading '$'")
+	}
+
+	id, _, _ := strings.Cut(rest, "$")
+	if !isValidID(id) {
+		return "", fmt.Errorf("phc: invalid function identifier %q", id)
+	}
+
+	return id, nil
+}
+
+// isValidID reports whether id is made of 1 to 32 characters in [a-z0-9-].
+func isValidID(id string) bool {
+	if len(id) == 0 || len(id) > maxIDLength {
+		return false
+	}
+
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+			return false
+		}
+	}
+
+	return true
+}
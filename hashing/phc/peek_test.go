@@ -0,0 +1,40 @@
+package phc
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPeekID(t *testing.T) {
+	maxID := strings.Repeat("a", maxIDLength)
+
+	tests := []struct {
+		name    string
+		raw     string
+		want    string
+		wantErr bool
+	}{
+		{name: "full hash", raw: "$argon2id$v=19$m=1$YWJj$ZGVm", want: "argon2id"},
+		{name: "id only", raw: "$argon2id", want: "argon2id"},
+		{name: "id with trailing separator", raw: "$argon2id$", want: "argon2id"},
+		{name: "missing leading separator", raw: "argon2id", wantErr: true},
+		{name: "separator only", raw: "$", wantErr: true},
+		{name: "empty id", raw: "$$x", wantErr: true},
+		{name: "uppercase id", raw: "$ARGON$x", wantErr: true},
+		{name: "max length id", raw: "$" + maxID + "$x", want: maxID},
+		{name: "too long id", raw: "$" + maxID + "a$x", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PeekID(tt.raw)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PeekID(%q) error = %v, wantErr %v", tt.raw, err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("PeekID(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
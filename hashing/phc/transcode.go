@@ -0,0 +1,84 @@
+package phc
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Transcode re-encodes the salt and hash segments of the PHC string raw from
+// the base64 alphabet from to the alphabet to, for example from
+// base64.RawURLEncoding to the canonical base64.RawStdEncoding. Every other
+// part of raw is kept verbatim.
+//
+// The version and parameter segments are the ones containing '=' and
+// segments are separated by '$'. Because of that, from and to must both be
+// unpadded encodings whose alphabets contain neither '$' nor '='.
+func Transcode(raw string, from, to *base64.Encoding) (string, error) {
+	if isPadded(from) || isPadded(to) {
+		return "", errors.New("phc: transcoding requires unpadded base64 encodings")
+	}
+
+	if hasReservedSymbol(from) || hasReservedSymbol(to) {
+		return "", errors.New("phc: transcoding requires base64 alphabets without '$' or '='")
+	}
+
+	if _, err := PeekID(raw); err != nil {
+		return "", err
+	}
+
+	segments := strings.Split(raw, "$")
+
+	// segments[0] is the empty string before the leading '$' and segments[1]
+	// the function identifier. They may be followed by a version segment and
+	// a parameter segment.
+	first := 2
+	for first < len(segments) && first < 4 && strings.Contains(segments[first], "=") {
+		first++
+	}
+
+	if first < len(segments) && strings.Contains(segments[first], "=") {
+		return "", errors.New("phc: too many parameter segments")
+	}
+
+	if len(segments)-first > 2 {
+		return "", errors.New("phc: too many segments")
+	}
+
+	names := [...]string{"salt", "hash"}
+	for i := first; i < len(segments); i++ {
+		name := names[i-first]
+
+		if segments[i] == "" {
+			return "", fmt.Errorf("phc: empty %s segment", name)
+		}
+
+		b, err := from.DecodeString(segments[i])
+		if err != nil {
+			return "", fmt.Errorf("phc: cannot decode %s segment: %w", name, err)
+		}
+
+		segments[i] = to.EncodeToString(b)
+	}
+
+	return strings.Join(segments, "$"), nil
+}
+
+// alphabetProbe encodes to all 64 symbols of any base64 alphabet, as it is
+// the standard alphabet decoded with the standard encoding.
+var alphabetProbe, _ = base64.StdEncoding.DecodeString(
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/",
+)
+
+// isPadded reports whether enc pads its output, whatever the padding
+// character is.
+func isPadded(enc *base64.Encoding) bool {
+	return enc.EncodedLen(1) != 2
+}
+
+// hasReservedSymbol reports whether the alphabet of enc contains a character
+// that is structural in a PHC string.
+func hasReservedSymbol(enc *base64.Encoding) bool {
+	return strings.ContainsAny(enc.EncodeToString(alphabetProbe), "$=")
+}
@@ -0,0 +1,116 @@
+package phc
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestTranscode_URLToStd(t *testing.T) {
+	salt := []byte{0xfb, 0xff, 0xfe, 0x01, 0x02, 0x03}
+	hash := []byte{0xff, 0xfe, 0xfb, 0x09, 0x08, 0x07}
+	prefix := "$argon2id$v=19$m=65536,t=3,p=4$"
+
+	raw := prefix + base64.RawURLEncoding.EncodeToString(salt) + "$" + base64.RawURLEncoding.EncodeToString(hash)
+	if !strings.ContainsAny(raw, "-_") {
+		t.Fatalf("test input %q does not exercise URL-only characters", raw)
+	}
+
+	got, err := Transcode(raw, base64.RawURLEncoding, base64.RawStdEncoding)
+	if err != nil {
+		t.Fatalf("Transcode(%q): %v", raw, err)
+	}
+
+	rest, ok := strings.CutPrefix(got, prefix)
+	if !ok {
+		t.Fatalf("Transcode(%q) = %q, want prefix %q", raw, got, prefix)
+	}
+
+	segments := strings.Split(rest, "$")
+	if len(segments) != 2 {
+		t.Fatalf("Transcode(%q) = %q, want salt and hash segments", raw, got)
+	}
+
+	for i, want := range [][]byte{salt, hash} {
+		b, err := base64.RawStdEncoding.DecodeString(segments[i])
+		if err != nil {
+			t.Fatalf("segment %q is not standard base64: %v", segments[i], err)
+		}
+
+		if !bytes.Equal(b, want) {
+			t.Errorf("segment %q decodes to %x, want %x", segments[i], b, want)
+		}
+	}
+}
+
+func TestTranscode_Unchanged(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  string
+	}{
+		{name: "id only", raw: "$argon2id"},
+		{name: "version and params only", raw: "$argon2id$v=19$m=65536,t=3,p=4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Transcode(tt.raw, base64.RawURLEncoding, base64.RawStdEncoding)
+			if err != nil {
+				t.Fatalf("Transcode(%q): %v", tt.raw, err)
+			}
+
+			if got != tt.raw {
+				t.Errorf("Transcode(%q) = %q, want input unchanged", tt.raw, got)
+			}
+		})
+	}
+}
+
+func TestTranscode_Errors(t *testing.T) {
+	const alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+
+	tests := []struct {
+		name string
+		raw  string
+		from *base64.Encoding
+		to   *base64.Encoding
+	}{
+		{name: "padded encoding", raw: "$argon2id", from: base64.URLEncoding},
+		{
+			name: "padded encoding with custom character",
+			raw:  "$argon2id$v=19$m=1$YWJj$ZGVmZw",
+			from: base64.RawStdEncoding,
+			to:   base64.StdEncoding.WithPadding('*'),
+		},
+		{
+			name: "alphabet with separator",
+			raw:  "$argon2id",
+			from: base64.RawURLEncoding,
+			to:   base64.NewEncoding(alphabet + "$/").WithPadding(base64.NoPadding),
+		},
+		{
+			name: "alphabet with equal sign",
+			raw:  "$argon2id",
+			from: base64.NewEncoding(alphabet + "+=").WithPadding(base64.NoPadding),
+		},
+		{name: "too many segments", raw: "$argon2id$v=19$m=1$YWJj$ZGVm$Z2hp", from: base64.RawURLEncoding},
+		{name: "too many parameter segments", raw: "$x$v=1$m=1$k=2$aa", from: base64.RawURLEncoding},
+		{name: "empty salt", raw: "$argon2id$v=19$m=1$$ZGVm", from: base64.RawURLEncoding},
+		{name: "empty hash", raw: "$argon2id$v=19$m=1$YWJj$", from: base64.RawURLEncoding},
+		{name: "invalid base64", raw: "$argon2id$v=19$m=1$YW!j$ZGVm", from: base64.RawURLEncoding},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to := tt.to
+			if to == nil {
+				to = base64.RawStdEncoding
+			}
+
+			if got, err := Transcode(tt.raw, tt.from, to); err == nil {
+				t.Errorf("Transcode(%q) = %q, want error", tt.raw, got)
+			}
+		})
+	}
+}